@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// QuotaViolation describes a single quota violation for WithQuotaFailure.
+type QuotaViolation struct {
+	Subject     string
+	Description string
+}
+
+// PreconditionViolation describes a single precondition violation for
+// WithPreconditionFailure.
+type PreconditionViolation struct {
+	Type        string
+	Subject     string
+	Description string
+}
+
+// HelpLink is a single documentation link for WithHelp.
+type HelpLink struct {
+	Description string
+	URL         string
+}
+
+// WithRetryInfo used to pass a RetryInfo detail, advising clients how long
+// to wait before retrying, to the Error struct.
+func WithRetryInfo(retryDelay time.Duration) Option {
+	return func(e *Error) {
+		e.retryInfo = &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(retryDelay),
+		}
+	}
+}
+
+// WithDebugInfo used to pass a DebugInfo detail to the Error struct.
+func WithDebugInfo(stackEntries []string, detail string) Option {
+	return func(e *Error) {
+		e.debugInfo = &errdetails.DebugInfo{
+			StackEntries: stackEntries,
+			Detail:       detail,
+		}
+	}
+}
+
+// WithQuotaFailure used to pass a QuotaFailure detail to the Error struct.
+func WithQuotaFailure(violations []QuotaViolation) Option {
+	return func(e *Error) {
+		qf := &errdetails.QuotaFailure{}
+		for _, v := range violations {
+			qf.Violations = append(qf.Violations, &errdetails.QuotaFailure_Violation{
+				Subject:     v.Subject,
+				Description: v.Description,
+			})
+		}
+		e.quotaFailure = qf
+	}
+}
+
+// WithPreconditionFailure used to pass a PreconditionFailure detail to the
+// Error struct.
+func WithPreconditionFailure(violations []PreconditionViolation) Option {
+	return func(e *Error) {
+		pf := &errdetails.PreconditionFailure{}
+		for _, v := range violations {
+			pf.Violations = append(pf.Violations, &errdetails.PreconditionFailure_Violation{
+				Type:        v.Type,
+				Subject:     v.Subject,
+				Description: v.Description,
+			})
+		}
+		e.preconditionFailure = pf
+	}
+}
+
+// WithRequestInfo used to pass a RequestInfo detail to the Error struct.
+func WithRequestInfo(requestID, servingData string) Option {
+	return func(e *Error) {
+		e.requestInfo = &errdetails.RequestInfo{
+			RequestId:   requestID,
+			ServingData: servingData,
+		}
+	}
+}
+
+// WithHelp used to pass a Help detail to the Error struct.
+func WithHelp(links []HelpLink) Option {
+	return func(e *Error) {
+		h := &errdetails.Help{}
+		for _, l := range links {
+			h.Links = append(h.Links, &errdetails.Help_Link{
+				Description: l.Description,
+				Url:         l.URL,
+			})
+		}
+		e.help = h
+	}
+}
+
+// WithLocalizedMessage used to pass a LocalizedMessage detail to the Error
+// struct.
+func WithLocalizedMessage(locale, message string) Option {
+	return func(e *Error) {
+		e.localizedMessage = &errdetails.LocalizedMessage{
+			Locale:  locale,
+			Message: message,
+		}
+	}
+}
+
+// WithFieldViolation appends a per-field validation error to the Error's
+// BadRequest detail, creating it on first use.
+func WithFieldViolation(field, description string) Option {
+	return func(e *Error) {
+		if e.badRequest == nil {
+			e.badRequest = &errdetails.BadRequest{}
+		}
+		e.badRequest.FieldViolations = append(e.badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: description,
+		})
+	}
+}
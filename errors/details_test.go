@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func detailsOf(t *testing.T, e *Error) []interface{} {
+	t.Helper()
+	details := e.GRPCStatus().Proto().GetDetails()
+	out := make([]interface{}, 0, len(details))
+	for _, d := range details {
+		msg, err := d.UnmarshalNew()
+		require.NoError(t, err)
+		out = append(out, msg)
+	}
+	return out
+}
+
+func TestWithDebugInfo(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithDebugInfo([]string{"frame1", "frame2"}, "panic recovered"))
+
+	var found *errdetails.DebugInfo
+	for _, d := range detailsOf(t, e) {
+		if di, ok := d.(*errdetails.DebugInfo); ok {
+			found = di
+		}
+	}
+	require.NotNil(t, found)
+	require.Equal(t, []string{"frame1", "frame2"}, found.GetStackEntries())
+	require.Equal(t, "panic recovered", found.GetDetail())
+}
+
+func TestWithQuotaFailure(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithQuotaFailure([]QuotaViolation{
+		{Subject: "user:42", Description: "rate limit exceeded"},
+	}))
+
+	var found *errdetails.QuotaFailure
+	for _, d := range detailsOf(t, e) {
+		if qf, ok := d.(*errdetails.QuotaFailure); ok {
+			found = qf
+		}
+	}
+	require.NotNil(t, found)
+	require.Len(t, found.GetViolations(), 1)
+	require.Equal(t, "user:42", found.GetViolations()[0].GetSubject())
+	require.Equal(t, "rate limit exceeded", found.GetViolations()[0].GetDescription())
+}
+
+func TestWithPreconditionFailure(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithPreconditionFailure([]PreconditionViolation{
+		{Type: "TOS", Subject: "user:42", Description: "terms not accepted"},
+	}))
+
+	var found *errdetails.PreconditionFailure
+	for _, d := range detailsOf(t, e) {
+		if pf, ok := d.(*errdetails.PreconditionFailure); ok {
+			found = pf
+		}
+	}
+	require.NotNil(t, found)
+	require.Len(t, found.GetViolations(), 1)
+	require.Equal(t, "TOS", found.GetViolations()[0].GetType())
+	require.Equal(t, "user:42", found.GetViolations()[0].GetSubject())
+	require.Equal(t, "terms not accepted", found.GetViolations()[0].GetDescription())
+}
+
+func TestWithRequestInfo(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithRequestInfo("req-1", "serving-data"))
+
+	var found *errdetails.RequestInfo
+	for _, d := range detailsOf(t, e) {
+		if ri, ok := d.(*errdetails.RequestInfo); ok {
+			found = ri
+		}
+	}
+	require.NotNil(t, found)
+	require.Equal(t, "req-1", found.GetRequestId())
+	require.Equal(t, "serving-data", found.GetServingData())
+}
+
+func TestWithHelp(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithHelp([]HelpLink{
+		{Description: "docs", URL: "https://docs.dapr.io"},
+	}))
+
+	var found *errdetails.Help
+	for _, d := range detailsOf(t, e) {
+		if h, ok := d.(*errdetails.Help); ok {
+			found = h
+		}
+	}
+	require.NotNil(t, found)
+	require.Len(t, found.GetLinks(), 1)
+	require.Equal(t, "docs", found.GetLinks()[0].GetDescription())
+	require.Equal(t, "https://docs.dapr.io", found.GetLinks()[0].GetUrl())
+}
+
+func TestWithLocalizedMessage(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithLocalizedMessage("en-US", "widget not found"))
+
+	var found *errdetails.LocalizedMessage
+	for _, d := range detailsOf(t, e) {
+		if lm, ok := d.(*errdetails.LocalizedMessage); ok {
+			found = lm
+		}
+	}
+	require.NotNil(t, found)
+	require.Equal(t, "en-US", found.GetLocale())
+	require.Equal(t, "widget not found", found.GetMessage())
+}
+
+func TestWithFieldViolation(t *testing.T) {
+	e := New(stderrors.New("nope"), nil,
+		WithFieldViolation("name", "must not be empty"),
+		WithFieldViolation("age", "must be positive"),
+	)
+
+	var found *errdetails.BadRequest
+	for _, d := range detailsOf(t, e) {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			found = br
+		}
+	}
+	require.NotNil(t, found)
+	require.Len(t, found.GetFieldViolations(), 2)
+	require.Equal(t, "name", found.GetFieldViolations()[0].GetField())
+	require.Equal(t, "must not be empty", found.GetFieldViolations()[0].GetDescription())
+	require.Equal(t, "age", found.GetFieldViolations()[1].GetField())
+}
@@ -16,6 +16,7 @@ package errors
 import (
 	"net/http"
 
+	customerrors "github.com/dapr/kit/pkg/proto/customerrors/v1"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -39,6 +40,13 @@ var UnknownErrorReason = WithErrorReason(unknown, unknownHTTPCode, codes.Unknown
 type ResourceInfo struct {
 	Type string
 	Name string
+
+	// Owner and Description are optional overrides for the ResourceInfo
+	// detail's Owner/Description fields. When left empty, GRPCStatus falls
+	// back to the package Owner constant and the wrapped err's message,
+	// respectively.
+	Owner       string
+	Description string
 }
 
 // Option allows passing additional information
@@ -54,13 +62,23 @@ type Option func(*Error)
 //   - metadata information
 //   - optional resourceInfo (componenttype/name)
 type Error struct {
-	err            error
-	description    string
-	reason         string
-	httpCode       int
-	grpcStatusCode codes.Code
-	metadata       map[string]string
-	resourceInfo   *ResourceInfo
+	err              error
+	description      string
+	reason           string
+	httpCode         int
+	grpcStatusCode   codes.Code
+	metadata         map[string]string
+	resourceInfo     *ResourceInfo
+	daprKitErrorInfo *customerrors.DaprKitErrorInfo
+
+	retryInfo           *errdetails.RetryInfo
+	debugInfo           *errdetails.DebugInfo
+	quotaFailure        *errdetails.QuotaFailure
+	preconditionFailure *errdetails.PreconditionFailure
+	badRequest          *errdetails.BadRequest
+	requestInfo         *errdetails.RequestInfo
+	help                *errdetails.Help
+	localizedMessage    *errdetails.LocalizedMessage
 }
 
 // New create a new Error using the supplied metadata and Options
@@ -143,6 +161,23 @@ func WithMetadata(md map[string]string) Option {
 	}
 }
 
+// WithDaprKitErrorInfo used to pass a DaprKitErrorInfo detail to the Error
+// struct, so it is attached to the gRPC status uniformly whether the
+// caller used the low-level status.ConstructError or this builder.
+func WithDaprKitErrorInfo(domain, key, reason string, metadata map[string]string) Option {
+	return func(e *Error) {
+		md := map[string]string{"key": key}
+		for k, v := range metadata {
+			md[k] = v
+		}
+		e.daprKitErrorInfo = &customerrors.DaprKitErrorInfo{
+			Domain:   domain,
+			Reason:   reason,
+			Metadata: md,
+		}
+	}
+}
+
 func newErrorInfo(reason string, md map[string]string) *errdetails.ErrorInfo {
 	return &errdetails.ErrorInfo{
 		Domain:   Domain,
@@ -152,11 +187,21 @@ func newErrorInfo(reason string, md map[string]string) *errdetails.ErrorInfo {
 }
 
 func newResourceInfo(rid *ResourceInfo, err error) *errdetails.ResourceInfo {
+	owner := rid.Owner
+	if owner == "" {
+		owner = Owner
+	}
+
+	description := rid.Description
+	if description == "" {
+		description = err.Error()
+	}
+
 	return &errdetails.ResourceInfo{
 		ResourceType: rid.Type,
 		ResourceName: rid.Name,
-		Owner:        Owner,
-		Description:  err.Error(),
+		Owner:        owner,
+		Description:  description,
 	}
 }
 
@@ -171,6 +216,33 @@ func (e *Error) GRPCStatus() *status.Status {
 	if e.resourceInfo != nil {
 		messages = append(messages, newResourceInfo(e.resourceInfo, e.err))
 	}
+	if e.daprKitErrorInfo != nil {
+		messages = append(messages, e.daprKitErrorInfo)
+	}
+	if e.retryInfo != nil {
+		messages = append(messages, e.retryInfo)
+	}
+	if e.debugInfo != nil {
+		messages = append(messages, e.debugInfo)
+	}
+	if e.quotaFailure != nil {
+		messages = append(messages, e.quotaFailure)
+	}
+	if e.preconditionFailure != nil {
+		messages = append(messages, e.preconditionFailure)
+	}
+	if e.badRequest != nil {
+		messages = append(messages, e.badRequest)
+	}
+	if e.requestInfo != nil {
+		messages = append(messages, e.requestInfo)
+	}
+	if e.help != nil {
+		messages = append(messages, e.help)
+	}
+	if e.localizedMessage != nil {
+		messages = append(messages, e.localizedMessage)
+	}
 
 	ste, stErr := status.New(e.grpcStatusCode, e.description).WithDetails(messages...)
 	if stErr != nil {
@@ -186,6 +258,10 @@ func (e *Error) GRPCStatus() *status.Status {
 // a GRPC Status and then Marshals it to JSON.
 // It assumes if the supplied error is of type Error.
 // Otherwise, returns the original error.
+//
+// ToHTTP always renders the protojson body regardless of the caller's
+// Accept header; use ToHTTPWithRequest or WriteHTTP for content negotiation
+// across the registered HTTPRenderers.
 func (e *Error) ToHTTP() (int, []byte) {
 	resp, err := protojson.Marshal(e.GRPCStatus().Proto())
 	if err != nil {
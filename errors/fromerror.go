@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	customerrors "github.com/dapr/kit/pkg/proto/customerrors/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// FromError is the symmetric counterpart to GRPCStatus(): given an error
+// returned over gRPC (or wrapping a *status.Status), it rebuilds an *Error
+// with reason, metadata, resourceInfo, description and the grpc/http codes
+// populated, so callers can use errors.As/errors.Is on the result.
+//
+// If err does not carry a gRPC status, FromError returns an *Error wrapping
+// err with the unknown reason.
+func FromError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return New(err, nil, WithDescription(err.Error()))
+	}
+
+	de := &Error{
+		err:            stderrors.New(st.Message()),
+		description:    st.Message(),
+		reason:         unknown,
+		httpCode:       grpcCodeToHTTPStatus(st.Code()),
+		grpcStatusCode: st.Code(),
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			de.reason = d.GetReason()
+			de.metadata = d.GetMetadata()
+		case *errdetails.ResourceInfo:
+			de.resourceInfo = &ResourceInfo{
+				Type: d.GetResourceType(),
+				Name: d.GetResourceName(),
+			}
+		case *customerrors.DaprKitErrorInfo:
+			if de.reason == unknown || de.reason == "" {
+				de.reason = d.GetReason()
+			}
+			if de.metadata == nil {
+				de.metadata = d.GetMetadata()
+			}
+		}
+	}
+
+	return de
+}
+
+// FromHTTP rebuilds an *Error from an HTTP response produced by ToHTTP or
+// JSONErrorValue: statusCode is the HTTP status that was returned, and body
+// is the protojson-encoded google.rpc.Status payload.
+func FromHTTP(statusCode int, body []byte) *Error {
+	var sp spb.Status
+	if uErr := protojson.Unmarshal(body, &sp); uErr != nil {
+		return New(stderrors.New(string(body)), nil,
+			WithDescription(string(body)),
+			WithErrorReason(unknown, statusCode, codes.Unknown))
+	}
+
+	de := FromError(status.FromProto(&sp).Err())
+	if de != nil {
+		de.httpCode = statusCode
+	}
+
+	return de
+}
+
+// grpcCodeToHTTPStatus maps a gRPC status code to its conventional HTTP
+// status, following the same table used by grpc-gateway's runtime package.
+func grpcCodeToHTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.Unknown:
+		return http.StatusInternalServerError
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Aborted:
+		return http.StatusConflict
+	case codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Internal:
+		return http.StatusInternalServerError
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DataLoss:
+		return http.StatusInternalServerError
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
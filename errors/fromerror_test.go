@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestFromErrorRoundTrip(t *testing.T) {
+	original := New(stderrors.New("widget not found"),
+		nil,
+		WithErrorReason("NOT_FOUND", 404, codes.NotFound),
+		WithMetadata(map[string]string{"widgetID": "42"}),
+		WithResourceInfo(&ResourceInfo{Type: "widget", Name: "42"}),
+		WithDescription("widget not found"),
+	)
+
+	recovered := FromError(original.GRPCStatus().Err())
+	require.NotNil(t, recovered)
+	require.Equal(t, "NOT_FOUND", recovered.reason)
+	require.Equal(t, codes.NotFound, recovered.grpcStatusCode)
+	require.Equal(t, "widget not found", recovered.Description())
+	require.Equal(t, "42", recovered.metadata["widgetID"])
+	require.NotNil(t, recovered.resourceInfo)
+	require.Equal(t, "widget", recovered.resourceInfo.Type)
+	require.Equal(t, "42", recovered.resourceInfo.Name)
+}
+
+func TestFromErrorNonStatus(t *testing.T) {
+	recovered := FromError(stderrors.New("plain error"))
+	require.NotNil(t, recovered)
+	require.Equal(t, "plain error", recovered.Error())
+}
+
+func TestFromErrorNil(t *testing.T) {
+	require.Nil(t, FromError(nil))
+}
+
+func TestFromHTTPRoundTrip(t *testing.T) {
+	original := New(stderrors.New("widget not found"),
+		nil,
+		WithErrorReason("NOT_FOUND", 404, codes.NotFound),
+		WithDescription("widget not found"),
+	)
+
+	code, body := original.ToHTTP()
+	recovered := FromHTTP(code, body)
+
+	require.NotNil(t, recovered)
+	require.Equal(t, 404, recovered.HTTPCode())
+	require.Equal(t, "NOT_FOUND", recovered.reason)
+	require.Equal(t, "widget not found", recovered.Description())
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// HTTPRenderer renders an *Error as an HTTP response body of a given
+// content type. Renderers are selected by ToHTTPWithRequest/WriteHTTP based
+// on the request's Accept header.
+type HTTPRenderer interface {
+	// ContentType returns the media type this renderer produces.
+	ContentType() string
+	// Render serializes e as an HTTP response body.
+	Render(e *Error) []byte
+}
+
+// Built-in renderers, registered in negotiation order: the first one whose
+// ContentType matches the request's Accept header wins; protoJSONRenderer
+// is the fallback when nothing matches.
+var (
+	// ProtoJSONRenderer renders the google.rpc.Status protojson body, the
+	// same shape produced by the original ToHTTP/JSONErrorValue.
+	ProtoJSONRenderer HTTPRenderer = protoJSONRenderer{}
+
+	// ProblemDetailsRenderer renders an RFC 7807 application/problem+json
+	// body derived from reason/description/metadata/resourceInfo.
+	ProblemDetailsRenderer HTTPRenderer = problemDetailsRenderer{}
+
+	// GatewayRenderer renders a grpc-gateway compatible
+	// {"code","message","details":[...]} body, for services already fronted
+	// by grpc-gateway.
+	GatewayRenderer HTTPRenderer = gatewayRenderer{}
+
+	renderers = []HTTPRenderer{ProblemDetailsRenderer, GatewayRenderer, ProtoJSONRenderer}
+)
+
+type protoJSONRenderer struct{}
+
+func (protoJSONRenderer) ContentType() string { return "application/json" }
+
+func (protoJSONRenderer) Render(e *Error) []byte {
+	return e.JSONErrorValue()
+}
+
+type problemDetails struct {
+	Type     string            `json:"type,omitempty"`
+	Title    string            `json:"title"`
+	Status   int               `json:"status"`
+	Detail   string            `json:"detail,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Resource *ResourceInfo     `json:"resource,omitempty"`
+}
+
+type problemDetailsRenderer struct{}
+
+func (problemDetailsRenderer) ContentType() string { return "application/problem+json" }
+
+func (problemDetailsRenderer) Render(e *Error) []byte {
+	pd := problemDetails{
+		Title:    e.reason,
+		Status:   e.HTTPCode(),
+		Detail:   e.Description(),
+		Metadata: e.metadata,
+		Resource: e.resourceInfo,
+	}
+	b, err := json.Marshal(pd)
+	if err != nil {
+		return []byte(err.Error())
+	}
+	return b
+}
+
+type gatewayError struct {
+	Code    int               `json:"code"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+type gatewayRenderer struct{}
+
+func (gatewayRenderer) ContentType() string { return "application/vnd.grpc-gateway+json" }
+
+func (gatewayRenderer) Render(e *Error) []byte {
+	ge := gatewayError{
+		Code:    int(e.grpcStatusCode),
+		Message: e.Description(),
+	}
+
+	for _, d := range e.GRPCStatus().Proto().GetDetails() {
+		b, err := protojson.Marshal(d)
+		if err != nil {
+			continue
+		}
+		ge.Details = append(ge.Details, b)
+	}
+
+	b, err := json.Marshal(ge)
+	if err != nil {
+		return []byte(err.Error())
+	}
+	return b
+}
+
+// negotiateRenderer picks the first registered renderer whose content type
+// appears in the Accept header, falling back to ProtoJSONRenderer.
+func negotiateRenderer(accept string) HTTPRenderer {
+	if accept == "" {
+		return ProtoJSONRenderer
+	}
+
+	for _, r := range renderers {
+		if strings.Contains(accept, r.ContentType()) {
+			return r
+		}
+	}
+
+	return ProtoJSONRenderer
+}
+
+// ToHTTPWithRequest negotiates a renderer based on r's Accept header and
+// returns the HTTP status code, the chosen Content-Type, and the rendered
+// body.
+func (e *Error) ToHTTPWithRequest(r *http.Request) (int, string, []byte) {
+	renderer := negotiateRenderer(r.Header.Get("Accept"))
+	return e.HTTPCode(), renderer.ContentType(), renderer.Render(e)
+}
+
+// WriteHTTP writes e to w as the response to r: it negotiates the body via
+// ToHTTPWithRequest, sets Content-Type, emits a Retry-After header when a
+// RetryInfo detail is present, and writes the status and body. This
+// replaces the boilerplate every Dapr HTTP handler otherwise repeats.
+func (e *Error) WriteHTTP(w http.ResponseWriter, r *http.Request) {
+	code, contentType, body := e.ToHTTPWithRequest(r)
+
+	if e.retryInfo.GetRetryDelay() != nil {
+		w.Header().Set("Retry-After", strconv.Itoa(int(e.retryInfo.GetRetryDelay().AsDuration().Seconds())))
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(code)
+	w.Write(body)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestNegotiateRenderer(t *testing.T) {
+	tests := map[string]struct {
+		accept   string
+		expected HTTPRenderer
+	}{
+		"empty accept falls back to protojson":     {accept: "", expected: ProtoJSONRenderer},
+		"unmatched accept falls back to protojson": {accept: "text/plain", expected: ProtoJSONRenderer},
+		"problem+json negotiates problem details":  {accept: "application/problem+json", expected: ProblemDetailsRenderer},
+		"gateway vendor type negotiates gateway":   {accept: "application/vnd.grpc-gateway+json, */*", expected: GatewayRenderer},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.expected, negotiateRenderer(tt.accept))
+		})
+	}
+}
+
+func TestToHTTPWithRequestNegotiatesContentType(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithErrorReason("NOT_FOUND", http.StatusNotFound, codes.NotFound))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept", "application/problem+json")
+
+	code, contentType, body := e.ToHTTPWithRequest(r)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, "application/problem+json", contentType)
+	require.Contains(t, string(body), "NOT_FOUND")
+}
+
+func TestWriteHTTPSetsRetryAfter(t *testing.T) {
+	e := New(stderrors.New("try later"), nil,
+		WithErrorReason("UNAVAILABLE", http.StatusServiceUnavailable, codes.Unavailable),
+		WithRetryInfo(30*time.Second),
+	)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	e.WriteHTTP(w, r)
+
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, "30", w.Header().Get("Retry-After"))
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package middleware provides gRPC interceptors and an HTTP middleware
+// that translate *errors.Error returned by handlers into the appropriate
+// gRPC status or HTTP response, eliminating the per-handler serialization
+// code Dapr components otherwise repeat.
+package middleware
+
+import (
+	"context"
+
+	"github.com/dapr/kit/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor detects a *errors.Error returned by the handler
+// and translates it to its gRPC status, so that ErrorInfo/ResourceInfo and
+// any other attached details propagate to the client. Unknown errors are
+// enriched with a default ErrorInfo carrying the unknown reason.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, translate(err)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return translate(handler(srv, ss))
+	}
+}
+
+// translate converts err into its gRPC status if it is (or wraps) an
+// *errors.Error or already carries one (status.FromError reports ok=true
+// for any error implementing GRPCStatus, including *errors.Error and
+// errors produced by status.Error/status.New), leaving its code and
+// message untouched. Any other error is enriched with the default unknown
+// ErrorInfo so clients always receive a consistent detail shape, without
+// losing the original message.
+func translate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+
+	return errors.New(err, nil, errors.UnknownErrorReason, errors.WithDescription(err.Error())).GRPCStatus().Err()
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/dapr/kit/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTranslateNil(t *testing.T) {
+	require.NoError(t, translate(nil))
+}
+
+func TestTranslatePreservesExistingGRPCStatus(t *testing.T) {
+	original := status.Error(codes.NotFound, "widget not found")
+
+	got := translate(original)
+
+	st, ok := status.FromError(got)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+	require.Equal(t, "widget not found", st.Message())
+}
+
+func TestTranslatePreservesErrorsError(t *testing.T) {
+	de := errors.New(stderrors.New("widget not found"), nil,
+		errors.WithErrorReason("NOT_FOUND", 404, codes.NotFound),
+		errors.WithDescription("widget not found"),
+	)
+
+	got := translate(de)
+
+	st, ok := status.FromError(got)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+	require.Equal(t, "widget not found", st.Message())
+}
+
+func TestTranslateEnrichesPlainError(t *testing.T) {
+	got := translate(stderrors.New("boom"))
+
+	st, ok := status.FromError(got)
+	require.True(t, ok)
+	require.Equal(t, codes.Unknown, st.Code())
+	require.Equal(t, "boom", st.Message())
+
+	var reason string
+	for _, d := range st.Details() {
+		if ei, ok := d.(*errdetails.ErrorInfo); ok {
+			reason = ei.GetReason()
+		}
+	}
+	require.Equal(t, "UNKNOWN_REASON", reason)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/dapr/kit/errors"
+)
+
+type errCtxKey struct{}
+
+// SetError records err on ctx so Handler can render it once the wrapped
+// handler returns. It is a no-op if ctx was not derived from a request
+// that passed through Handler.
+func SetError(ctx context.Context, err error) {
+	if box, ok := ctx.Value(errCtxKey{}).(*error); ok {
+		*box = err
+	}
+}
+
+// Handler wraps next with panic recovery and *errors.Error translation: if
+// the handler panics, or calls SetError(ctx, err) with a non-nil err, the
+// negotiated HTTP response is written via (*errors.Error).WriteHTTP to the
+// same ResponseWriter next used. Output from next is not buffered: if next
+// has already written a status or body, those stay, the error's status code
+// is not applied, and the error body follows what next wrote. It is a plain
+// http.Handler so it composes
+// with gin's WrapH and chi's middleware chains the same way any other
+// net/http middleware does.
+func Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var captured error
+		ctx := context.WithValue(r.Context(), errCtxKey{}, &captured)
+		r = r.WithContext(ctx)
+
+		defer func() {
+			if rec := recover(); rec != nil {
+				log.Printf("middleware: recovered panic handling %s %s: %v\n%s", r.Method, r.URL.Path, rec, debug.Stack())
+
+				renderErr, ok := rec.(error)
+				if !ok {
+					renderErr = fmt.Errorf("internal server error: %v", rec)
+				}
+				render(w, r, renderErr)
+			}
+		}()
+
+		next.ServeHTTP(w, r)
+
+		if captured != nil {
+			render(w, r, captured)
+		}
+	})
+}
+
+// render writes err to w using the negotiated HTTPRenderer, wrapping it in
+// the default unknown ErrorInfo first if it is not already an *errors.Error.
+// The original error's message is preserved as the description so it isn't
+// silently dropped for handlers that haven't adopted *errors.Error yet.
+func render(w http.ResponseWriter, r *http.Request, err error) {
+	var de *errors.Error
+	if !stderrors.As(err, &de) {
+		de = errors.New(err, nil, errors.UnknownErrorReason, errors.WithDescription(err.Error()))
+	}
+	de.WriteHTTP(w, r)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dapr/kit/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestHandlerWritesNegotiatedResponseOnSetError(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		SetError(r.Context(), errors.New(stderrors.New("widget not found"), nil,
+			errors.WithErrorReason("NOT_FOUND", http.StatusNotFound, codes.NotFound),
+			errors.WithDescription("widget not found"),
+		))
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	Handler(next).ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Contains(t, w.Body.String(), "widget not found")
+}
+
+func TestHandlerPassesThroughWhenNoErrorSet(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("ok"))
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	Handler(next).ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, "ok", w.Body.String())
+}
+
+func TestHandlerRecoversPanicAsInternalServerError(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+
+	require.NotPanics(t, func() {
+		Handler(next).ServeHTTP(w, r)
+	})
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestSetErrorNoopWithoutHandler(t *testing.T) {
+	require.NotPanics(t, func() {
+		SetError(httptest.NewRequest(http.MethodGet, "/", nil).Context(), stderrors.New("ignored"))
+	})
+}
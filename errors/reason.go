@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Reason is a canonical, comparable Dapr error reason. It implements the
+// error interface so it can be used both as a sentinel with errors.Is and
+// as an Option via WithReason.
+type Reason struct {
+	name           string
+	httpCode       int
+	grpcStatusCode codes.Code
+}
+
+// Error implements the error interface, returning the reason name.
+func (r Reason) Error() string {
+	return r.name
+}
+
+// Canonical Dapr error reasons. Each carries a preset (reason, httpCode,
+// grpcCode) triple so callers get a stable, comparable error taxonomy
+// instead of raw strings.
+var (
+	ErrNotFound           = Reason{name: "NOT_FOUND", httpCode: http.StatusNotFound, grpcStatusCode: codes.NotFound}
+	ErrAlreadyExists      = Reason{name: "ALREADY_EXISTS", httpCode: http.StatusConflict, grpcStatusCode: codes.AlreadyExists}
+	ErrPermissionDenied   = Reason{name: "PERMISSION_DENIED", httpCode: http.StatusForbidden, grpcStatusCode: codes.PermissionDenied}
+	ErrResourceExhausted  = Reason{name: "RESOURCE_EXHAUSTED", httpCode: http.StatusTooManyRequests, grpcStatusCode: codes.ResourceExhausted}
+	ErrFailedPrecondition = Reason{name: "FAILED_PRECONDITION", httpCode: http.StatusBadRequest, grpcStatusCode: codes.FailedPrecondition}
+	ErrUnavailable        = Reason{name: "UNAVAILABLE", httpCode: http.StatusServiceUnavailable, grpcStatusCode: codes.Unavailable}
+	ErrDataLoss           = Reason{name: "DATA_LOSS", httpCode: http.StatusInternalServerError, grpcStatusCode: codes.DataLoss}
+	ErrUnauthenticated    = Reason{name: "UNAUTHENTICATED", httpCode: http.StatusUnauthorized, grpcStatusCode: codes.Unauthenticated}
+	ErrDeadlineExceeded   = Reason{name: "DEADLINE_EXCEEDED", httpCode: http.StatusGatewayTimeout, grpcStatusCode: codes.DeadlineExceeded}
+	ErrCanceled           = Reason{name: "CANCELED", httpCode: 499, grpcStatusCode: codes.Canceled}
+
+	// ErrMethodNotFound and ErrNoConnection mirror the custom, out-of-range
+	// codes declared in status.METHOD_NOT_FOUND and status.NO_CONNECTION
+	// (8088/8089). The values are duplicated here rather than imported to
+	// avoid a dependency from errors on status.
+	ErrMethodNotFound = Reason{name: "METHOD_NOT_FOUND", httpCode: http.StatusNotFound, grpcStatusCode: codes.Code(8088)}
+	ErrNoConnection   = Reason{name: "NO_CONNECTION", httpCode: http.StatusServiceUnavailable, grpcStatusCode: codes.Code(8089)}
+)
+
+// reasons lists every canonical Reason, used by Resolve to find the best
+// match along an error's unwrap chain.
+var reasons = []Reason{
+	ErrNotFound,
+	ErrAlreadyExists,
+	ErrPermissionDenied,
+	ErrResourceExhausted,
+	ErrFailedPrecondition,
+	ErrUnavailable,
+	ErrDataLoss,
+	ErrUnauthenticated,
+	ErrDeadlineExceeded,
+	ErrCanceled,
+	ErrMethodNotFound,
+	ErrNoConnection,
+}
+
+// Is implements the interface used by errors.Is so that
+// errors.Is(e, ErrNotFound) succeeds whenever e's reason matches target's.
+func (e *Error) Is(target error) bool {
+	r, ok := target.(Reason)
+	if !ok {
+		return false
+	}
+	return e.reason == r.name
+}
+
+// WithReason is a shortcut for WithErrorReason(sentinel.name, sentinel.httpCode,
+// sentinel.grpcStatusCode).
+func WithReason(sentinel Reason) Option {
+	return WithErrorReason(sentinel.name, sentinel.httpCode, sentinel.grpcStatusCode)
+}
+
+// Resolve walks err's unwrap chain - falling back to the Is interface when
+// no Unwrap is available - and returns the best matching canonical Reason,
+// mirroring containerd's errdefs.Resolve pattern. If nothing matches, err
+// itself is returned unchanged.
+func Resolve(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if stderrors.Is(err, context.DeadlineExceeded) {
+		return ErrDeadlineExceeded
+	}
+	if stderrors.Is(err, context.Canceled) {
+		return ErrCanceled
+	}
+
+	for _, r := range reasons {
+		if stderrors.Is(err, r) {
+			return r
+		}
+	}
+
+	return err
+}
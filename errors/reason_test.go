@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorIsSentinel(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithReason(ErrNotFound))
+
+	require.True(t, stderrors.Is(e, ErrNotFound))
+	require.False(t, stderrors.Is(e, ErrAlreadyExists))
+}
+
+func TestResolveMatchesSentinel(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithReason(ErrPermissionDenied))
+
+	resolved := Resolve(e)
+	require.Equal(t, ErrPermissionDenied, resolved)
+}
+
+func TestResolveWrappedSentinel(t *testing.T) {
+	e := New(stderrors.New("nope"), nil, WithReason(ErrUnavailable))
+	wrapped := fmt.Errorf("calling service: %w", e)
+
+	require.Equal(t, ErrUnavailable, Resolve(wrapped))
+}
+
+func TestResolveContextErrors(t *testing.T) {
+	require.Equal(t, ErrDeadlineExceeded, Resolve(context.DeadlineExceeded))
+	require.Equal(t, ErrCanceled, Resolve(context.Canceled))
+}
+
+func TestResolveNoMatch(t *testing.T) {
+	plain := stderrors.New("unrelated")
+	require.Equal(t, plain, Resolve(plain))
+}
+
+func TestResolveNil(t *testing.T) {
+	require.Nil(t, Resolve(nil))
+}
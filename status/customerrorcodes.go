@@ -1,11 +1,28 @@
 package status
 
 import (
+	"math"
+
 	"google.golang.org/grpc/codes"
 )
 
+// Custom Dapr gRPC codes. codes.Code is a uint32, but the gRPC wire format
+// and the standard library only define values 0-16; Dapr reserves the
+// 8000-8999 range for its own codes so they never collide with future
+// standard additions. Gateways that marshal the code as a signed int32
+// (as grpc-gateway does) must go through ToInt32 rather than a bare
+// conversion, since values above math.MaxInt32 would overflow.
 const (
 	METHOD_NOT_FOUND codes.Code = 8088
 
 	NO_CONNECTION codes.Code = 8089
 )
+
+// ToInt32 safely converts a custom Dapr code for wire transmission,
+// falling back to codes.Unknown if the value would overflow an int32.
+func ToInt32(code codes.Code) int32 {
+	if uint64(code) > math.MaxInt32 {
+		return int32(codes.Unknown)
+	}
+	return int32(code)
+}
@@ -1,24 +1,59 @@
 package status
 
 import (
+	"github.com/dapr/kit/errors"
 	customerrors "github.com/dapr/kit/pkg/proto/customerrors/v1"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// ConstructError builds a gRPC status carrying ErrorInfo, ResourceInfo and
+// DaprKitErrorInfo details.
+//
+// Deprecated: ConstructError and the errors.Error builder used to be two
+// divergent ways of producing the same status. ConstructError now builds
+// an *errors.Error via the Option pattern internally and delegates to
+// GRPCStatus, so DaprKitErrorInfo is attached uniformly either way; prefer
+// constructing an *errors.Error directly with errors.New.
 func ConstructError(code codes.Code, err error, key, errDescription, resourceType, reason, owner, domain, resourceName string, metadata map[string]string) error {
-	ei := ConstructErrorInfo(domain, key, reason, metadata)
-	ri := ConstructResourceInfo(owner, resourceName, errDescription, resourceType)
-	kei := ConstructDaprKitErrorInfo(domain, key, reason, metadata)
-	ste, stErr := status.Newf(code, errDescription).WithDetails(ei, ri, kei)
-	if stErr != nil {
-		return err
+	if err == nil {
+		// errors.New requires a non-nil err, so fall back to building the
+		// status directly rather than panicking on a nil *errors.Error.
+		ei := ConstructErrorInfo(domain, key, reason, metadata)
+		ri := ConstructResourceInfo(owner, resourceName, errDescription, resourceType)
+		kei := ConstructDaprKitErrorInfo(domain, key, reason, metadata)
+		ste, stErr := status.Newf(code, errDescription).WithDetails(ei, ri, kei)
+		if stErr != nil {
+			return err
+		}
+		return ste.Err()
 	}
 
-	return ste.Err()
+	md := map[string]string{"key": key}
+	for k, v := range metadata {
+		md[k] = v
+	}
+
+	de := errors.New(err,
+		md,
+		errors.WithErrorReason(reason, 0, code),
+		errors.WithDescription(errDescription),
+		errors.WithMetadata(md),
+		errors.WithResourceInfo(&errors.ResourceInfo{
+			Type:        resourceType,
+			Name:        resourceName,
+			Owner:       owner,
+			Description: errDescription,
+		}),
+		errors.WithDaprKitErrorInfo(domain, key, reason, metadata),
+	)
+
+	return de.GRPCStatus().Err()
 }
 
+// Deprecated: construct an *errors.Error and call GRPCStatus instead, which
+// attaches the equivalent ErrorInfo detail.
 func ConstructErrorInfo(domain, key, reason string, metadata map[string]string) *errdetails.ErrorInfo {
 	ei := errdetails.ErrorInfo{
 		Domain: domain,
@@ -33,6 +68,8 @@ func ConstructErrorInfo(domain, key, reason string, metadata map[string]string)
 	return &ei
 }
 
+// Deprecated: use errors.WithDaprKitErrorInfo with the errors.Error builder
+// instead.
 func ConstructDaprKitErrorInfo(domain, key, reason string, metadata map[string]string) *customerrors.DaprKitErrorInfo {
 	ei := customerrors.DaprKitErrorInfo{
 		Domain: domain,
@@ -47,6 +84,8 @@ func ConstructDaprKitErrorInfo(domain, key, reason string, metadata map[string]s
 	return &ei
 }
 
+// Deprecated: use errors.WithResourceInfo with the errors.Error builder
+// instead.
 func ConstructResourceInfo(owner, resourceName, description string, resourceType string) *errdetails.ResourceInfo {
 	return &errdetails.ResourceInfo{
 		ResourceType: resourceType,
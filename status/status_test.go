@@ -0,0 +1,38 @@
+package status
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConstructErrorNilErr(t *testing.T) {
+	err := ConstructError(codes.NotFound, nil, "widget-42", "widget not found", "widget", "NOT_FOUND", "my-owner", "dapr.io", "widget-42", nil)
+	require.Error(t, err)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+	require.Equal(t, "widget not found", st.Message())
+}
+
+func TestConstructErrorPreservesOwnerAndDescription(t *testing.T) {
+	err := ConstructError(codes.NotFound, stderrors.New("raw go error"), "widget-42", "widget not found", "widget", "NOT_FOUND", "my-owner", "dapr.io", "widget-42", nil)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+
+	var found *errdetails.ResourceInfo
+	for _, d := range st.Details() {
+		if ri, ok := d.(*errdetails.ResourceInfo); ok {
+			found = ri
+		}
+	}
+	require.NotNil(t, found)
+	require.Equal(t, "my-owner", found.GetOwner())
+	require.Equal(t, "widget not found", found.GetDescription())
+}